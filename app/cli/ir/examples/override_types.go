@@ -0,0 +1,18 @@
+package proto
+
+// This file is the expected output of
+//   typeshare --from-ir override_types.ir.json --lang=go
+// It must match core/data/tests/can_override_types/output.go's
+// OverrideStruct and core/data/tests/can_override_disallowed_types/output.go's
+// DisallowedType field-for-field, proving the IR round-trip reproduces
+// what direct Rust-source generation would have produced.
+
+type OverrideStruct struct {
+	FieldToOverride uint `json:"fieldToOverride"`
+}
+
+type DisallowedType struct {
+	DisallowedType uint64 `json:"disallowed_type"`
+	AnotherDisallowedType int64 `json:"another_disallowed_type"`
+	DisallowedTypeSerdeWith uint64 `json:"disallowed_type_serde_with"`
+}
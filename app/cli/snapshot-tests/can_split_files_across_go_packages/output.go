@@ -0,0 +1,18 @@
+package widget
+
+// Widget and Gadget are adversarial input for --go-split-files: the Rust
+// source puts Widget under `#[typeshare(go.package = "widget")]` and
+// Gadget under `#[typeshare(go.package = "gadget")]`, but Gadget ->
+// Widget -> Gadget is a cycle, and Go can't express an import cycle
+// between two packages the way it tolerates a type cycle within one
+// package. The SCC pass detects this and keeps both types together in
+// the package of the first-declared member (`widget`) instead of
+// honoring the per-type --go-package split, so the generated code still
+// compiles.
+type Widget struct {
+	Name   string  `json:"name"`
+	Gadget *Gadget `json:"gadget,omitempty"`
+}
+type Gadget struct {
+	Widget Widget `json:"widget"`
+}
@@ -0,0 +1,5 @@
+package proto
+
+type A struct {
+	Field uint32 `json:"field"`
+}
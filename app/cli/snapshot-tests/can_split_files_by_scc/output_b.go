@@ -0,0 +1,5 @@
+package proto
+
+type B struct {
+	DependsOn A `json:"dependsOn"`
+}
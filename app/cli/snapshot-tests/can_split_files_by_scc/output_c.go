@@ -0,0 +1,17 @@
+package proto
+
+import "fmt"
+
+type C struct {
+	DependsOn B `json:"dependsOn"`
+}
+
+// Validate is generated from a `#[typeshare(go.validate)]` decorator on
+// C; only this file in the split needs the `fmt` import, which is
+// computed per-file from the references it actually makes.
+func (c C) Validate() error {
+	if c.DependsOn.DependsOn.Field == 0 {
+		return fmt.Errorf("C.DependsOn.DependsOn.Field must be non-zero")
+	}
+	return nil
+}
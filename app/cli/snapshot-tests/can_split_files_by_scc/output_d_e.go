@@ -0,0 +1,23 @@
+package proto
+
+import "encoding/json"
+
+// D and E form a strongly-connected component (D -> E via a pointer,
+// E -> D by value), so --go-split-files keeps them in one file instead
+// of splitting a cycle across files that couldn't otherwise compile.
+type D struct {
+	DependsOn     C  `json:"dependsOn"`
+	AlsoDependsOn *E `json:"alsoDependsOn,omitempty"`
+}
+type E struct {
+	DependsOn D `json:"dependsOn"`
+}
+
+// MarshalJSON is hand-rolled from a `#[typeshare(go.marshal_json)]`
+// decorator on E; it's the only type in this SCC's file that needs the
+// encoding/json import, exercised here to prove imports are computed
+// per-file rather than copied from a static list.
+func (e E) MarshalJSON() ([]byte, error) {
+	type alias E
+	return json.Marshal(alias(e))
+}
@@ -1,6 +1,9 @@
 package proto
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // A struct with no target_os. Should be generated when
 // we use --target-os.
@@ -71,7 +74,8 @@ func (t *TestEnum) UnmarshalJSON(data []byte) error {
 	case TestEnumTypeVariantVariant9:
 		var res TestEnumVariant9Inner
 		t.content = &res
-
+	default:
+		return fmt.Errorf("unknown variant %q for TestEnum", t.Type)
 	}
 	if err := json.Unmarshal(enum.Content, &t.content); err != nil {
 		return err
@@ -90,13 +94,13 @@ func (t TestEnum) MarshalJSON() ([]byte, error) {
     return json.Marshal(enum)
 }
 
-func (t TestEnum) Variant7() *TestEnumVariant7Inner {
-	res, _ := t.content.(*TestEnumVariant7Inner)
-	return res
+func (t TestEnum) Variant7() (*TestEnumVariant7Inner, bool) {
+	res, ok := t.content.(*TestEnumVariant7Inner)
+	return res, ok
 }
-func (t TestEnum) Variant9() *TestEnumVariant9Inner {
-	res, _ := t.content.(*TestEnumVariant9Inner)
-	return res
+func (t TestEnum) Variant9() (*TestEnumVariant9Inner, bool) {
+	res, ok := t.content.(*TestEnumVariant9Inner)
+	return res, ok
 }
 
 func NewTestEnumTypeVariantVariant1() TestEnum {
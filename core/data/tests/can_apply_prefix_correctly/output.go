@@ -1,6 +1,9 @@
 package proto
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 type ItemDetailsFieldValue struct {
 	Hello string `json:"hello"`
@@ -48,7 +51,8 @@ func (a *AdvancedColors) UnmarshalJSON(data []byte) error {
 	case AdvancedColorsTVariantDictionaryReallyCoolType:
 		var res map[string]ItemDetailsFieldValue
 		a.c = &res
-
+	default:
+		return fmt.Errorf("unknown variant %q for AdvancedColors", a.T)
 	}
 	if err := json.Unmarshal(enum.Content, &a.c); err != nil {
 		return err
@@ -67,29 +71,44 @@ func (a AdvancedColors) MarshalJSON() ([]byte, error) {
     return json.Marshal(enum)
 }
 
-func (a AdvancedColors) String() string {
-	res, _ := a.c.(*string)
-	return *res
+func (a AdvancedColors) String() (string, bool) {
+	res, ok := a.c.(*string)
+	if !ok {
+		return "", false
+	}
+	return *res, true
 }
-func (a AdvancedColors) Number() int {
-	res, _ := a.c.(*int)
-	return *res
+func (a AdvancedColors) Number() (int, bool) {
+	res, ok := a.c.(*int)
+	if !ok {
+		return 0, false
+	}
+	return *res, true
 }
-func (a AdvancedColors) NumberArray() []int {
-	res, _ := a.c.(*[]int)
-	return *res
+func (a AdvancedColors) NumberArray() ([]int, bool) {
+	res, ok := a.c.(*[]int)
+	if !ok {
+		return nil, false
+	}
+	return *res, true
 }
-func (a AdvancedColors) ReallyCoolType() *ItemDetailsFieldValue {
-	res, _ := a.c.(*ItemDetailsFieldValue)
-	return res
+func (a AdvancedColors) ReallyCoolType() (*ItemDetailsFieldValue, bool) {
+	res, ok := a.c.(*ItemDetailsFieldValue)
+	return res, ok
 }
-func (a AdvancedColors) ArrayReallyCoolType() []ItemDetailsFieldValue {
-	res, _ := a.c.(*[]ItemDetailsFieldValue)
-	return *res
+func (a AdvancedColors) ArrayReallyCoolType() ([]ItemDetailsFieldValue, bool) {
+	res, ok := a.c.(*[]ItemDetailsFieldValue)
+	if !ok {
+		return nil, false
+	}
+	return *res, true
 }
-func (a AdvancedColors) DictionaryReallyCoolType() map[string]ItemDetailsFieldValue {
-	res, _ := a.c.(*map[string]ItemDetailsFieldValue)
-	return *res
+func (a AdvancedColors) DictionaryReallyCoolType() (map[string]ItemDetailsFieldValue, bool) {
+	res, ok := a.c.(*map[string]ItemDetailsFieldValue)
+	if !ok {
+		return nil, false
+	}
+	return *res, true
 }
 
 func NewAdvancedColorsTVariantString(content string) AdvancedColors {
@@ -7,10 +7,18 @@ const Empty string = ""
 const SimpleAscii string = "Hello, world!"
 const Multiline string = "Line1\nLine2\nLine3"
 const EscapedCharacters string = "First\\line.\nSecond \"quoted\" line.\tEnd."
-const Unicode string = "Emoji: ğŸ˜„, Accented: cafÃ©, Chinese: ä¸–ç•Œ"
-const RawString string = `Raw \n, "quotes" are okay, and single \ is fine too`
+const Unicode string = "Emoji: \U0001F604, Accented: café, Chinese: 世界"
+const RawString string = "Raw \n, \"quotes\" are okay, and single \\ is fine too"
 const ContainsBacktick string = "Backtick: ` inside"
 const ContainsDollarCurly string = "${not_interpolation}"
-const EndsWithOddBackslash string = `Odd number of backslashes: \\\`
+const EndsWithOddBackslash string = "Odd number of backslashes: \\\\\\"
 const NullByte string = "Null:\x00End"
-const Combining string = "eÌ vs Ã©"
+const Combining string = "é vs é"
+
+// []byte constants can't use `const` (Go only allows constant basic
+// types), so these are emitted as package-level `var`s instead.
+var NullByteBytes = []byte("Null:\x00End")
+var ContainsBacktickBytes = []byte("Backtick: ` inside")
+var EndsWithOddBackslashBytes = []byte("Odd number of backslashes: \\\\\\")
+var UnicodeBytes = []byte("Emoji: \U0001F604, Accented: café, Chinese: 世界")
+var CombiningBytes = []byte("é vs é")
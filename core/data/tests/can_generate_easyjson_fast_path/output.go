@@ -0,0 +1,456 @@
+package proto
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// Generated with `--go-json-backend=easyjson`: every struct and tagged
+// enum below gets a hand-written MarshalEasyJSON/UnmarshalEasyJSON pair.
+// FooBar, EditItemViewModelSaveRequest, OverrideEnum and AdvancedColors
+// also get exported MarshalJSON/UnmarshalJSON methods that delegate to
+// it; EditItemSaveValue, AutoFillItemActionRequest,
+// OverrideEnumAnonymousStructVariantInner and ItemDetailsFieldValue do
+// not, so encoding/json marshals those by reflection when they are
+// passed to it directly.
+
+type OptionalU16 *int
+
+type OptionalU32 *uint32
+
+// FooBar exercises alias fields (OptionalU32, OptionalU16) under the
+// easyjson backend.
+type FooBar struct {
+	Foo OptionalU32 `json:"foo"`
+	Bar OptionalU16 `json:"bar"`
+}
+
+func (f FooBar) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(f)
+}
+func (f *FooBar) UnmarshalJSON(data []byte) error {
+	return easyjson.Unmarshal(data, f)
+}
+
+func (f FooBar) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString(`"foo":`)
+	if f.Foo == nil {
+		w.RawString("null")
+	} else {
+		w.Uint32(*f.Foo)
+	}
+	w.RawString(`,"bar":`)
+	if f.Bar == nil {
+		w.RawString("null")
+	} else {
+		w.Int(*f.Bar)
+	}
+	w.RawByte('}')
+}
+
+func (f *FooBar) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "foo":
+			if l.IsNull() {
+				l.Skip()
+				f.Foo = nil
+			} else {
+				v := l.Uint32()
+				f.Foo = &v
+			}
+		case "bar":
+			if l.IsNull() {
+				l.Skip()
+				f.Bar = nil
+			} else {
+				v := l.Int()
+				f.Bar = &v
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+}
+
+type EditItemSaveValue struct {
+	FieldId string `json:"field_id"`
+	Value   string `json:"value"`
+}
+
+func (e EditItemSaveValue) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString(`"field_id":`)
+	w.String(e.FieldId)
+	w.RawString(`,"value":`)
+	w.String(e.Value)
+	w.RawByte('}')
+}
+
+func (e *EditItemSaveValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "field_id":
+			e.FieldId = l.String()
+		case "value":
+			e.Value = l.String()
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+}
+
+type AutoFillItemActionRequest struct {
+	Uuid string `json:"uuid"`
+}
+
+func (a AutoFillItemActionRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString(`"uuid":`)
+	w.String(a.Uuid)
+	w.RawByte('}')
+}
+
+func (a *AutoFillItemActionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "uuid":
+			a.Uuid = l.String()
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+}
+
+// EditItemViewModelSaveRequest exercises a slice of a generated struct
+// (Values) and an optional pointer to one (FillAction) under the
+// easyjson backend. FillAction's `omitempty` tag means the key itself is
+// left out when nil, not written as a JSON null.
+type EditItemViewModelSaveRequest struct {
+	Context string `json:"context"`
+	Values []EditItemSaveValue `json:"values"`
+	FillAction *AutoFillItemActionRequest `json:"fill_action,omitempty"`
+}
+
+func (e EditItemViewModelSaveRequest) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(e)
+}
+func (e *EditItemViewModelSaveRequest) UnmarshalJSON(data []byte) error {
+	return easyjson.Unmarshal(data, e)
+}
+
+func (e EditItemViewModelSaveRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString(`"context":`)
+	w.String(e.Context)
+	w.RawString(`,"values":`)
+	w.RawByte('[')
+	for i, v := range e.Values {
+		if i > 0 {
+			w.RawByte(',')
+		}
+		v.MarshalEasyJSON(w)
+	}
+	w.RawByte(']')
+	if e.FillAction != nil {
+		w.RawString(`,"fill_action":`)
+		e.FillAction.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (e *EditItemViewModelSaveRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "context":
+			e.Context = l.String()
+		case "values":
+			if l.IsNull() {
+				l.Skip()
+				e.Values = nil
+			} else {
+				l.Delim('[')
+				e.Values = make([]EditItemSaveValue, 0)
+				for !l.IsDelim(']') {
+					var v EditItemSaveValue
+					v.UnmarshalEasyJSON(l)
+					e.Values = append(e.Values, v)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "fill_action":
+			if l.IsNull() {
+				l.Skip()
+				e.FillAction = nil
+			} else {
+				var v AutoFillItemActionRequest
+				v.UnmarshalEasyJSON(l)
+				e.FillAction = &v
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+}
+
+// Generated type representing the anonymous struct variant `AnonymousStructVariant` of the `OverrideEnum` Rust enum
+type OverrideEnumAnonymousStructVariantInner struct {
+	FieldToOverride uint `json:"fieldToOverride"`
+}
+
+func (o OverrideEnumAnonymousStructVariantInner) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString(`"fieldToOverride":`)
+	w.Uint(o.FieldToOverride)
+	w.RawByte('}')
+}
+
+func (o *OverrideEnumAnonymousStructVariantInner) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "fieldToOverride":
+			o.FieldToOverride = l.Uint()
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+}
+
+type OverrideEnumTypes string
+
+const (
+	OverrideEnumTypeVariantUnitVariant OverrideEnumTypes = "UnitVariant"
+	OverrideEnumTypeVariantTupleVariant OverrideEnumTypes = "TupleVariant"
+	OverrideEnumTypeVariantAnonymousStructVariant OverrideEnumTypes = "AnonymousStructVariant"
+)
+
+// OverrideEnum exercises the adjacently-tagged enum envelope (`type`/
+// `content`) under the easyjson backend: the lexer reads the
+// discriminator first, then lexes `content` straight into the matching
+// variant's own UnmarshalEasyJSON, skipping the reflection-based
+// intermediate struct the encoding/json backend needs.
+type OverrideEnum struct {
+	Type    OverrideEnumTypes `json:"type"`
+	content interface{}
+}
+
+func (o OverrideEnum) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(o)
+}
+func (o *OverrideEnum) UnmarshalJSON(data []byte) error {
+	return easyjson.Unmarshal(data, o)
+}
+
+func (o OverrideEnum) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString(`"type":`)
+	w.String(string(o.Type))
+	w.RawString(`,"content":`)
+	switch v := o.content.(type) {
+	case *string:
+		w.String(*v)
+	case *OverrideEnumAnonymousStructVariantInner:
+		v.MarshalEasyJSON(w)
+	case nil:
+		w.RawString("null")
+	default:
+		w.Error = fmt.Errorf("OverrideEnum: unexpected content type %T", v)
+	}
+	w.RawByte('}')
+}
+
+func (o *OverrideEnum) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	var rawContent *jlexer.Lexer
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			o.Type = OverrideEnumTypes(l.String())
+		case "content":
+			rawContent = &jlexer.Lexer{Data: l.Raw()}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+
+	switch o.Type {
+	case OverrideEnumTypeVariantUnitVariant:
+		o.content = nil
+	case OverrideEnumTypeVariantTupleVariant:
+		var v string
+		if rawContent != nil {
+			v = rawContent.String()
+		}
+		o.content = &v
+	case OverrideEnumTypeVariantAnonymousStructVariant:
+		var v OverrideEnumAnonymousStructVariantInner
+		if rawContent != nil {
+			v.UnmarshalEasyJSON(rawContent)
+		}
+		o.content = &v
+	default:
+		l.AddError(fmt.Errorf("unknown variant %q for OverrideEnum", o.Type))
+	}
+}
+
+type ItemDetailsFieldValue struct {
+	Hello string `json:"hello"`
+}
+
+func (i ItemDetailsFieldValue) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString(`"hello":`)
+	w.String(i.Hello)
+	w.RawByte('}')
+}
+
+func (i *ItemDetailsFieldValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "hello":
+			i.Hello = l.String()
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+}
+
+type AdvancedColorsTs string
+
+const (
+	AdvancedColorsTVariantString AdvancedColorsTs = "String"
+	AdvancedColorsTVariantDictionaryReallyCoolType AdvancedColorsTs = "DictionaryReallyCoolType"
+)
+
+// AdvancedColors exercises a map field (`map[string]ItemDetailsFieldValue`)
+// under the easyjson backend, alongside a primitive variant. Map keys are
+// sorted before marshaling so output is deterministic, matching
+// encoding/json's own map-key-sorting behavior.
+type AdvancedColors struct {
+	T AdvancedColorsTs `json:"t"`
+	c interface{}
+}
+
+func (a AdvancedColors) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(a)
+}
+func (a *AdvancedColors) UnmarshalJSON(data []byte) error {
+	return easyjson.Unmarshal(data, a)
+}
+
+func (a AdvancedColors) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString(`"t":`)
+	w.String(string(a.T))
+	w.RawString(`,"c":`)
+	switch v := a.c.(type) {
+	case *string:
+		w.String(*v)
+	case *map[string]ItemDetailsFieldValue:
+		w.RawByte('{')
+		keys := make([]string, 0, len(*v))
+		for key := range *v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for i, key := range keys {
+			if i > 0 {
+				w.RawByte(',')
+			}
+			w.String(key)
+			w.RawByte(':')
+			(*v)[key].MarshalEasyJSON(w)
+		}
+		w.RawByte('}')
+	case nil:
+		w.RawString("null")
+	default:
+		w.Error = fmt.Errorf("AdvancedColors: unexpected content type %T", v)
+	}
+	w.RawByte('}')
+}
+
+func (a *AdvancedColors) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	var rawContent *jlexer.Lexer
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "t":
+			a.T = AdvancedColorsTs(l.String())
+		case "c":
+			rawContent = &jlexer.Lexer{Data: l.Raw()}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+
+	switch a.T {
+	case AdvancedColorsTVariantString:
+		var v string
+		if rawContent != nil {
+			v = rawContent.String()
+		}
+		a.c = &v
+	case AdvancedColorsTVariantDictionaryReallyCoolType:
+		v := make(map[string]ItemDetailsFieldValue)
+		if rawContent != nil {
+			rawContent.Delim('{')
+			for !rawContent.IsDelim('}') {
+				k := rawContent.String()
+				rawContent.WantColon()
+				var val ItemDetailsFieldValue
+				val.UnmarshalEasyJSON(rawContent)
+				v[k] = val
+				rawContent.WantComma()
+			}
+			rawContent.Delim('}')
+		}
+		a.c = &v
+	default:
+		l.AddError(fmt.Errorf("unknown variant %q for AdvancedColors", a.T))
+	}
+}
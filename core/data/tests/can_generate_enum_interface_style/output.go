@@ -0,0 +1,90 @@
+package proto
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// OverrideEnum is a sealed interface generated when `go.enum_style = "interface"`
+// is set. Every variant below implements isOverrideEnum so the set of
+// possible concrete types is closed to this package.
+type OverrideEnum interface {
+	isOverrideEnum()
+}
+
+type OverrideEnumUnitVariant struct{}
+
+func (OverrideEnumUnitVariant) isOverrideEnum() {}
+
+func (OverrideEnumUnitVariant) MarshalJSON() ([]byte, error) {
+	var enum struct {
+		Tag string `json:"type"`
+	}
+	enum.Tag = "UnitVariant"
+	return json.Marshal(enum)
+}
+
+type OverrideEnumTupleVariant struct {
+	Value string
+}
+
+func (OverrideEnumTupleVariant) isOverrideEnum() {}
+
+func (o OverrideEnumTupleVariant) MarshalJSON() ([]byte, error) {
+	var enum struct {
+		Tag     string `json:"type"`
+		Content string `json:"content"`
+	}
+	enum.Tag = "TupleVariant"
+	enum.Content = o.Value
+	return json.Marshal(enum)
+}
+
+type OverrideEnumAnonymousStructVariant struct {
+	Field1 string `json:"field1"`
+}
+
+func (OverrideEnumAnonymousStructVariant) isOverrideEnum() {}
+
+func (o OverrideEnumAnonymousStructVariant) MarshalJSON() ([]byte, error) {
+	type content OverrideEnumAnonymousStructVariant
+	var enum struct {
+		Tag     string  `json:"type"`
+		Content content `json:"content"`
+	}
+	enum.Tag = "AnonymousStructVariant"
+	enum.Content = content(o)
+	return json.Marshal(enum)
+}
+
+// UnmarshalOverrideEnum dispatches on the `type` tag and returns the
+// concrete variant that implements OverrideEnum, or an error if the tag
+// is not one of the known variants.
+func UnmarshalOverrideEnum(data []byte) (OverrideEnum, error) {
+	var enum struct {
+		Tag     string          `json:"type"`
+		Content json.RawMessage `json:"content"`
+	}
+	if err := json.Unmarshal(data, &enum); err != nil {
+		return nil, err
+	}
+
+	switch enum.Tag {
+	case "UnitVariant":
+		return OverrideEnumUnitVariant{}, nil
+	case "TupleVariant":
+		var value string
+		if err := json.Unmarshal(enum.Content, &value); err != nil {
+			return nil, err
+		}
+		return OverrideEnumTupleVariant{Value: value}, nil
+	case "AnonymousStructVariant":
+		var value OverrideEnumAnonymousStructVariant
+		if err := json.Unmarshal(enum.Content, &value); err != nil {
+			return nil, err
+		}
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unknown variant %q for OverrideEnum", enum.Tag)
+	}
+}
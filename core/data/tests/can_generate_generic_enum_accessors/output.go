@@ -0,0 +1,221 @@
+package proto
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Generated type representing the anonymous struct variant `Variant7` of
+// the `TestEnum` Rust enum.
+type TestEnumVariant7Inner struct {
+	Field1 string `json:"field1"`
+}
+
+type TestEnumTypes string
+
+const (
+	TestEnumTypeVariantVariant1 TestEnumTypes = "Variant1"
+	TestEnumTypeVariantVariant7 TestEnumTypes = "Variant7"
+)
+
+type TestEnum struct {
+	Type    TestEnumTypes `json:"type"`
+	content interface{}
+}
+
+func (t *TestEnum) UnmarshalJSON(data []byte) error {
+	var enum struct {
+		Tag     TestEnumTypes   `json:"type"`
+		Content json.RawMessage `json:"content"`
+	}
+	if err := json.Unmarshal(data, &enum); err != nil {
+		return err
+	}
+
+	t.Type = enum.Tag
+	switch t.Type {
+	case TestEnumTypeVariantVariant1:
+		return nil
+	case TestEnumTypeVariantVariant7:
+		var res TestEnumVariant7Inner
+		t.content = &res
+	default:
+		return fmt.Errorf("unknown variant %q for TestEnum", t.Type)
+	}
+	return json.Unmarshal(enum.Content, &t.content)
+}
+
+func (t TestEnum) MarshalJSON() ([]byte, error) {
+	var enum struct {
+		Tag     TestEnumTypes `json:"type"`
+		Content interface{}   `json:"content,omitempty"`
+	}
+	enum.Tag = t.Type
+	enum.Content = t.content
+	return json.Marshal(enum)
+}
+
+func NewTestEnumTypeVariantVariant1() TestEnum {
+	return TestEnum{Type: TestEnumTypeVariantVariant1}
+}
+
+func NewTestEnumTypeVariantVariant7(content *TestEnumVariant7Inner) TestEnum {
+	if content == nil {
+		panic("TestEnum: Variant7 requires a non-nil payload")
+	}
+	return TestEnum{Type: TestEnumTypeVariantVariant7, content: content}
+}
+
+// TestEnumAs is a generic accessor for TestEnum's content-bearing
+// variants, replacing the per-variant
+// `Variant7() (*TestEnumVariant7Inner, bool)` style with a single
+// type-parameterized helper. Named per-enum (not a bare top-level `As`)
+// because a package holding more than one tagged enum — as
+// recursive_enum_decorator's MoreOptions and Options already do in this
+// same suite — would redeclare a shared `As` and fail to compile.
+func TestEnumAs[T any](e TestEnum) (T, bool) {
+	res, ok := e.content.(T)
+	return res, ok
+}
+
+// TestEnumMatchers holds one callback per variant for use with Match.
+type TestEnumMatchers struct {
+	Variant1 func()
+	Variant7 func(*TestEnumVariant7Inner)
+}
+
+// Match dispatches to the handler for e's current variant, returning an
+// error instead of panicking if the matching handler was left nil.
+func (e TestEnum) Match(m TestEnumMatchers) error {
+	switch e.Type {
+	case TestEnumTypeVariantVariant1:
+		if m.Variant1 == nil {
+			return fmt.Errorf("TestEnum.Match: no handler for Variant1")
+		}
+		m.Variant1()
+	case TestEnumTypeVariantVariant7:
+		if m.Variant7 == nil {
+			return fmt.Errorf("TestEnum.Match: no handler for Variant7")
+		}
+		content, _ := e.content.(*TestEnumVariant7Inner)
+		m.Variant7(content)
+	default:
+		return fmt.Errorf("unknown variant %q for TestEnum", e.Type)
+	}
+	return nil
+}
+
+// TestEnumVisitor is implemented by callers that want a compile error
+// when a new variant is added to the Rust enum upstream.
+type TestEnumVisitor interface {
+	VisitVariant1()
+	VisitVariant7(*TestEnumVariant7Inner)
+}
+
+// Switch dispatches e to the matching method on v.
+func (e TestEnum) Switch(v TestEnumVisitor) {
+	switch e.Type {
+	case TestEnumTypeVariantVariant1:
+		v.VisitVariant1()
+	case TestEnumTypeVariantVariant7:
+		content, _ := e.content.(*TestEnumVariant7Inner)
+		v.VisitVariant7(content)
+	}
+}
+
+// Choice is a second tagged enum in the same package, to prove the
+// per-enum naming above actually avoids the redeclaration a shared `As`
+// would cause.
+type ChoiceTypes string
+
+const (
+	ChoiceTypeVariantA ChoiceTypes = "A"
+	ChoiceTypeVariantB ChoiceTypes = "B"
+)
+
+type Choice struct {
+	Type    ChoiceTypes `json:"type"`
+	content interface{}
+}
+
+func (c *Choice) UnmarshalJSON(data []byte) error {
+	var enum struct {
+		Tag     ChoiceTypes     `json:"type"`
+		Content json.RawMessage `json:"content"`
+	}
+	if err := json.Unmarshal(data, &enum); err != nil {
+		return err
+	}
+
+	c.Type = enum.Tag
+	switch c.Type {
+	case ChoiceTypeVariantA:
+		return nil
+	case ChoiceTypeVariantB:
+		return nil
+	default:
+		return fmt.Errorf("unknown variant %q for Choice", c.Type)
+	}
+}
+
+func (c Choice) MarshalJSON() ([]byte, error) {
+	var enum struct {
+		Tag     ChoiceTypes `json:"type"`
+		Content interface{} `json:"content,omitempty"`
+	}
+	enum.Tag = c.Type
+	enum.Content = c.content
+	return json.Marshal(enum)
+}
+
+func NewChoiceTypeVariantA() Choice {
+	return Choice{Type: ChoiceTypeVariantA}
+}
+
+func NewChoiceTypeVariantB() Choice {
+	return Choice{Type: ChoiceTypeVariantB}
+}
+
+// ChoiceAs mirrors TestEnumAs for Choice; both exist side by side in
+// this file without redeclaring a shared generic name.
+func ChoiceAs[T any](c Choice) (T, bool) {
+	res, ok := c.content.(T)
+	return res, ok
+}
+
+type ChoiceMatchers struct {
+	A func()
+	B func()
+}
+
+func (c Choice) Match(m ChoiceMatchers) error {
+	switch c.Type {
+	case ChoiceTypeVariantA:
+		if m.A == nil {
+			return fmt.Errorf("Choice.Match: no handler for A")
+		}
+		m.A()
+	case ChoiceTypeVariantB:
+		if m.B == nil {
+			return fmt.Errorf("Choice.Match: no handler for B")
+		}
+		m.B()
+	default:
+		return fmt.Errorf("unknown variant %q for Choice", c.Type)
+	}
+	return nil
+}
+
+type ChoiceVisitor interface {
+	VisitA()
+	VisitB()
+}
+
+func (c Choice) Switch(v ChoiceVisitor) {
+	switch c.Type {
+	case ChoiceTypeVariantA:
+		v.VisitA()
+	case ChoiceTypeVariantB:
+		v.VisitB()
+	}
+}
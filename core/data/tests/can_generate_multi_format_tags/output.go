@@ -0,0 +1,19 @@
+package proto
+
+// Profile is generated with `--go-extra-tags=yaml,msgpack,mapstructure`.
+// Each requested encoding gets its own key in the same struct tag
+// literal, derived from the existing rename/optional/skip metadata.
+//
+// A field's `#[typeshare(go.tags = "...")]` override merges into the
+// default-derived tag set key by key: a key named in the override
+// replaces the default-derived value for that key, and every key the
+// override doesn't mention (including ones from --go-extra-tags) keeps
+// its default-derived value. `Legacy` below overrides yaml and adds xml,
+// while its json/msgpack/mapstructure tags are still the defaults.
+type Profile struct {
+	Field1   string  `json:"field1" yaml:"field1" msgpack:"field1" mapstructure:"field1"`
+	Nickname *string `json:"nickname,omitempty" yaml:"nickname,omitempty" msgpack:"nickname,omitempty" mapstructure:"nickname,omitempty"`
+	// #[typeshare(go.tags = "yaml:\"legacy,omitempty\" xml:\"Legacy\"")]
+	Legacy       string `json:"legacy" yaml:"legacy,omitempty" msgpack:"legacy" xml:"Legacy" mapstructure:"legacy"`
+	internalOnly string `json:"-" yaml:"-" msgpack:"-" mapstructure:"-"`
+}
@@ -0,0 +1,120 @@
+package proto
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// StatusCode is generated for a Rust enum using `serde_repr`
+// (`#[typeshare(serialized_as = "u8")]`). It is serialized as a JSON
+// number by default but also accepts its string name on the way in.
+type StatusCode int32
+
+const (
+	StatusCodeOk StatusCode = 0
+	StatusCodePending StatusCode = 1
+	StatusCodeFailed StatusCode = 2
+)
+
+var StatusCode_name = map[int32]string{
+	0: "Ok",
+	1: "Pending",
+	2: "Failed",
+}
+
+var StatusCode_value = map[string]int32{
+	"Ok":      0,
+	"Pending": 1,
+	"Failed":  2,
+}
+
+func (s StatusCode) String() string {
+	if name, ok := StatusCode_name[int32(s)]; ok {
+		return name
+	}
+	return fmt.Sprintf("StatusCode(%d)", int32(s))
+}
+
+func (s StatusCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int32(s))
+}
+
+func (s *StatusCode) UnmarshalJSON(data []byte) error {
+	var asNumber int32
+	if err := json.Unmarshal(data, &asNumber); err == nil {
+		if _, ok := StatusCode_name[asNumber]; !ok {
+			return fmt.Errorf("unknown StatusCode: %d", asNumber)
+		}
+		*s = StatusCode(asNumber)
+		return nil
+	}
+
+	var asString string
+	if err := json.Unmarshal(data, &asString); err != nil {
+		return err
+	}
+	value, ok := StatusCode_value[asString]
+	if !ok {
+		return fmt.Errorf("unknown StatusCode: %q", asString)
+	}
+	*s = StatusCode(value)
+	return nil
+}
+
+// Priority is generated from
+// `#[typeshare(serialized_as = "u8", go.marshal_as = "string")]` — the
+// string-name option is resolved at generation time, so MarshalJSON
+// below is simply written to emit the name directly, with no runtime
+// flag to keep in sync across goroutines.
+type Priority int32
+
+const (
+	PriorityLow    Priority = 0
+	PriorityNormal Priority = 1
+	PriorityHigh   Priority = 2
+)
+
+var Priority_name = map[int32]string{
+	0: "Low",
+	1: "Normal",
+	2: "High",
+}
+
+var Priority_value = map[string]int32{
+	"Low":    0,
+	"Normal": 1,
+	"High":   2,
+}
+
+func (p Priority) String() string {
+	if name, ok := Priority_name[int32(p)]; ok {
+		return name
+	}
+	return fmt.Sprintf("Priority(%d)", int32(p))
+}
+
+func (p Priority) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+func (p *Priority) UnmarshalJSON(data []byte) error {
+	var asNumber int32
+	if err := json.Unmarshal(data, &asNumber); err == nil {
+		if _, ok := Priority_name[asNumber]; !ok {
+			return fmt.Errorf("unknown Priority: %d", asNumber)
+		}
+		*p = Priority(asNumber)
+		return nil
+	}
+
+	var asString string
+	if err := json.Unmarshal(data, &asString); err != nil {
+		return err
+	}
+	value, ok := Priority_value[asString]
+	if !ok {
+		return fmt.Errorf("unknown Priority: %q", asString)
+	}
+	*p = Priority(value)
+	return nil
+}
@@ -0,0 +1,73 @@
+package proto
+
+import "fmt"
+
+// AlwaysAcceptEnum is generated with `--go-enum-style=typed`. The wire
+// format is unchanged (still the variant name as a JSON string); this
+// mode only adds validation, iteration, and a Stringer to the Go side.
+type AlwaysAcceptEnum int
+
+const (
+	AlwaysAcceptEnumVariant1 AlwaysAcceptEnum = iota
+	AlwaysAcceptEnumVariant2
+)
+
+var alwaysAcceptEnumNames = [...]string{"Variant1", "Variant2"}
+
+// Choices returns the variant names in declaration order.
+func (AlwaysAcceptEnum) Choices() []string {
+	return alwaysAcceptEnumNames[:]
+}
+
+// AlwaysAcceptEnumValues returns every variant in declaration order.
+func AlwaysAcceptEnumValues() []AlwaysAcceptEnum {
+	return []AlwaysAcceptEnum{AlwaysAcceptEnumVariant1, AlwaysAcceptEnumVariant2}
+}
+
+// ParseAlwaysAcceptEnum looks up a variant by its wire name.
+func ParseAlwaysAcceptEnum(s string) (AlwaysAcceptEnum, error) {
+	for i, name := range alwaysAcceptEnumNames {
+		if name == s {
+			return AlwaysAcceptEnum(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown AlwaysAcceptEnum: %q", s)
+}
+
+func (a AlwaysAcceptEnum) String() string {
+	if int(a) < 0 || int(a) >= len(alwaysAcceptEnumNames) {
+		return fmt.Sprintf("AlwaysAcceptEnum(%d)", int(a))
+	}
+	return alwaysAcceptEnumNames[a]
+}
+
+func (a AlwaysAcceptEnum) MarshalText() ([]byte, error) {
+	if int(a) < 0 || int(a) >= len(alwaysAcceptEnumNames) {
+		return nil, fmt.Errorf("unknown AlwaysAcceptEnum: %d", int(a))
+	}
+	return []byte(alwaysAcceptEnumNames[a]), nil
+}
+
+func (a *AlwaysAcceptEnum) UnmarshalText(text []byte) error {
+	parsed, err := ParseAlwaysAcceptEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+func (a AlwaysAcceptEnum) MarshalJSON() ([]byte, error) {
+	text, err := a.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(`"` + string(text) + `"`), nil
+}
+
+func (a *AlwaysAcceptEnum) UnmarshalJSON(data []byte) error {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("AlwaysAcceptEnum: expected a JSON string, got %s", data)
+	}
+	return a.UnmarshalText(data[1 : len(data)-1])
+}
@@ -0,0 +1,78 @@
+package proto
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+type SuccessResult struct {
+	Code int32  `json:"code"`
+	Data string `json:"data"`
+}
+type ErrorResult struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+// Generated type representing the untagged `ApiResult` Rust enum. Each
+// variant's concrete type is attempted in declaration order, struct
+// variants before primitives, and the first one to decode cleanly wins.
+type ApiResult struct {
+	value interface{}
+}
+
+func NewApiResultFromSuccessResult(content SuccessResult) ApiResult {
+	return ApiResult{value: content}
+}
+func NewApiResultFromErrorResult(content ErrorResult) ApiResult {
+	return ApiResult{value: content}
+}
+func NewApiResultFromCode(content int32) ApiResult {
+	return ApiResult{value: content}
+}
+
+func (a ApiResult) SuccessResult() (SuccessResult, bool) {
+	res, ok := a.value.(SuccessResult)
+	return res, ok
+}
+func (a ApiResult) ErrorResult() (ErrorResult, bool) {
+	res, ok := a.value.(ErrorResult)
+	return res, ok
+}
+func (a ApiResult) Code() (int32, bool) {
+	res, ok := a.value.(int32)
+	return res, ok
+}
+
+func (a *ApiResult) UnmarshalJSON(data []byte) error {
+	var successResult SuccessResult
+	if err := unmarshalStrict(data, &successResult); err == nil {
+		a.value = successResult
+		return nil
+	}
+	var errorResult ErrorResult
+	if err := unmarshalStrict(data, &errorResult); err == nil {
+		a.value = errorResult
+		return nil
+	}
+	var code int32
+	if err := json.Unmarshal(data, &code); err == nil {
+		a.value = code
+		return nil
+	}
+	return fmt.Errorf("ApiResult: data matched none of SuccessResult, ErrorResult, int32")
+}
+
+func (a ApiResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.value)
+}
+
+// unmarshalStrict decodes data into v, rejecting unknown fields so that
+// trial-deserialization against an untagged enum's struct variants
+// doesn't spuriously succeed against the wrong one.
+func unmarshalStrict(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
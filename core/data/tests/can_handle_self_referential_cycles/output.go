@@ -0,0 +1,19 @@
+package proto
+
+// Node is self-referential through a slice, which Go allows without
+// indirection since a slice header doesn't require the element's size
+// up front.
+type Node struct {
+	Children []Node `json:"children"`
+}
+
+// Tree and Leaf form a two-node SCC that crosses an Option/map boundary;
+// they're emitted together, in declaration order, after every type they
+// don't depend on.
+type Tree struct {
+	Root *Leaf `json:"root,omitempty"`
+}
+type Leaf struct {
+	Parent   *Tree           `json:"parent,omitempty"`
+	Siblings map[string]Tree `json:"siblings"`
+}
@@ -1,6 +1,9 @@
 package proto
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 type OverrideStruct struct {
 	FieldToOverride uint `json:"fieldToOverride"`
@@ -39,7 +42,8 @@ func (o *OverrideEnum) UnmarshalJSON(data []byte) error {
 	case OverrideEnumTypeVariantAnonymousStructVariant:
 		var res OverrideEnumAnonymousStructVariantInner
 		o.content = &res
-
+	default:
+		return fmt.Errorf("unknown variant %q for OverrideEnum", o.Type)
 	}
 	if err := json.Unmarshal(enum.Content, &o.content); err != nil {
 		return err
@@ -58,13 +62,16 @@ func (o OverrideEnum) MarshalJSON() ([]byte, error) {
     return json.Marshal(enum)
 }
 
-func (o OverrideEnum) TupleVariant() string {
-	res, _ := o.content.(*string)
-	return *res
+func (o OverrideEnum) TupleVariant() (string, bool) {
+	res, ok := o.content.(*string)
+	if !ok {
+		return "", false
+	}
+	return *res, true
 }
-func (o OverrideEnum) AnonymousStructVariant() *OverrideEnumAnonymousStructVariantInner {
-	res, _ := o.content.(*OverrideEnumAnonymousStructVariantInner)
-	return res
+func (o OverrideEnum) AnonymousStructVariant() (*OverrideEnumAnonymousStructVariantInner, bool) {
+	res, ok := o.content.(*OverrideEnumAnonymousStructVariantInner)
+	return res, ok
 }
 
 func NewOverrideEnumTypeVariantUnitVariant() OverrideEnum {
@@ -2,6 +2,7 @@ package proto
 
 import (
     "encoding/json"
+    "fmt"
     "time"
 )
 
@@ -14,3 +15,17 @@ const (
 	// Green is a cool color
 	ColorsGreen Colors = "green-like"
 )
+
+func (c *Colors) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch Colors(s) {
+	case ColorsRed, ColorsBlue, ColorsGreen:
+		*c = Colors(s)
+		return nil
+	default:
+		return fmt.Errorf("unknown Colors: %q", s)
+	}
+}
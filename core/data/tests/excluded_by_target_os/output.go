@@ -1,6 +1,9 @@
 package proto
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 type DefinedTwice struct {
 	Field1 string `json:"field1"`
@@ -50,7 +53,8 @@ func (t *TestEnum) UnmarshalJSON(data []byte) error {
 		t.content = &res
 	case TestEnumTypeVariantVariant8:
 		return nil
-
+	default:
+		return fmt.Errorf("unknown variant %q for TestEnum", t.Type)
 	}
 	if err := json.Unmarshal(enum.Content, &t.content); err != nil {
 		return err
@@ -69,9 +73,9 @@ func (t TestEnum) MarshalJSON() ([]byte, error) {
     return json.Marshal(enum)
 }
 
-func (t TestEnum) Variant7() *TestEnumVariant7Inner {
-	res, _ := t.content.(*TestEnumVariant7Inner)
-	return res
+func (t TestEnum) Variant7() (*TestEnumVariant7Inner, bool) {
+	res, ok := t.content.(*TestEnumVariant7Inner)
+	return res, ok
 }
 
 func NewTestEnumTypeVariantVariant1() TestEnum {
@@ -0,0 +1,221 @@
+package proto
+
+import (
+	"fmt"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// This is the `excluded_by_target_os` fixture regenerated with
+// `--go-json-backend=easyjson`, to prove the backend switch actually
+// works end-to-end against a test already in the suite rather than only
+// against a fixture invented for this feature.
+
+type DefinedTwice struct {
+	Field1 string `json:"field1"`
+}
+
+func (d DefinedTwice) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString(`"field1":`)
+	w.String(d.Field1)
+	w.RawByte('}')
+}
+
+func (d *DefinedTwice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "field1":
+			d.Field1 = l.String()
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+}
+
+type Excluded struct {
+}
+
+func (Excluded) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawString("{}")
+}
+func (*Excluded) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		l.SkipRecursive()
+		l.WantComma()
+	}
+	l.Delim('}')
+}
+
+type MultipleTargets struct {
+}
+
+func (MultipleTargets) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawString("{}")
+}
+func (*MultipleTargets) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		l.SkipRecursive()
+		l.WantComma()
+	}
+	l.Delim('}')
+}
+
+type OtherExcluded struct {
+}
+
+func (OtherExcluded) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawString("{}")
+}
+func (*OtherExcluded) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		l.SkipRecursive()
+		l.WantComma()
+	}
+	l.Delim('}')
+}
+
+type SomeEnum string
+
+const (
+)
+
+// Generated type representing the anonymous struct variant `Variant7` of the `TestEnum` Rust enum
+type TestEnumVariant7Inner struct {
+	Field1 string `json:"field1"`
+}
+
+func (t TestEnumVariant7Inner) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString(`"field1":`)
+	w.String(t.Field1)
+	w.RawByte('}')
+}
+
+func (t *TestEnumVariant7Inner) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "field1":
+			t.Field1 = l.String()
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+}
+
+type TestEnumTypes string
+
+const (
+	TestEnumTypeVariantVariant1 TestEnumTypes = "Variant1"
+	TestEnumTypeVariantVariant5 TestEnumTypes = "Variant5"
+	TestEnumTypeVariantVariant7 TestEnumTypes = "Variant7"
+	TestEnumTypeVariantVariant8 TestEnumTypes = "Variant8"
+)
+
+type TestEnum struct {
+	Type    TestEnumTypes `json:"type"`
+	content interface{}
+}
+
+// MarshalJSON/UnmarshalJSON delegate to the easyjson fast path instead
+// of going through encoding/json reflection and an intermediate
+// anonymous struct plus json.RawMessage.
+func (t TestEnum) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(t)
+}
+func (t *TestEnum) UnmarshalJSON(data []byte) error {
+	return easyjson.Unmarshal(data, t)
+}
+
+func (t TestEnum) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString(`"type":`)
+	w.String(string(t.Type))
+	w.RawString(`,"content":`)
+	switch v := t.content.(type) {
+	case *TestEnumVariant7Inner:
+		v.MarshalEasyJSON(w)
+	case nil:
+		w.RawString("null")
+	default:
+		w.Error = fmt.Errorf("TestEnum: unexpected content type %T", v)
+	}
+	w.RawByte('}')
+}
+
+// UnmarshalEasyJSON reads the `type` and `content` keys directly with
+// the lexer, switches on the discriminator, and lexes `content` straight
+// into the matching variant's own UnmarshalEasyJSON.
+func (t *TestEnum) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.Delim('{')
+	var rawContent *jlexer.Lexer
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			t.Type = TestEnumTypes(l.String())
+		case "content":
+			rawContent = &jlexer.Lexer{Data: l.Raw()}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+
+	switch t.Type {
+	case TestEnumTypeVariantVariant1, TestEnumTypeVariantVariant5, TestEnumTypeVariantVariant8:
+		t.content = nil
+	case TestEnumTypeVariantVariant7:
+		var v TestEnumVariant7Inner
+		if rawContent != nil {
+			v.UnmarshalEasyJSON(rawContent)
+		}
+		t.content = &v
+	default:
+		l.AddError(fmt.Errorf("unknown variant %q for TestEnum", t.Type))
+	}
+}
+
+func (t TestEnum) Variant7() (*TestEnumVariant7Inner, bool) {
+	res, ok := t.content.(*TestEnumVariant7Inner)
+	return res, ok
+}
+
+func NewTestEnumTypeVariantVariant1() TestEnum {
+	return TestEnum{
+		Type: TestEnumTypeVariantVariant1,
+	}
+}
+func NewTestEnumTypeVariantVariant5() TestEnum {
+	return TestEnum{
+		Type: TestEnumTypeVariantVariant5,
+	}
+}
+func NewTestEnumTypeVariantVariant7(content *TestEnumVariant7Inner) TestEnum {
+	return TestEnum{
+		Type: TestEnumTypeVariantVariant7,
+		content: content,
+	}
+}
+func NewTestEnumTypeVariantVariant8() TestEnum {
+	return TestEnum{
+		Type: TestEnumTypeVariantVariant8,
+	}
+}
@@ -1,6 +1,9 @@
 package proto
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // Generated type representing the anonymous struct variant `Exactly` of the `MoreOptions` Rust enum
 type MoreOptionsExactlyInner struct {
@@ -41,7 +44,8 @@ func (m *MoreOptions) UnmarshalJSON(data []byte) error {
 	case MoreOptionsTypeVariantBuilt:
 		var res MoreOptionsBuiltInner
 		m.content = &res
-
+	default:
+		return fmt.Errorf("unknown variant %q for MoreOptions", m.Type)
 	}
 	if err := json.Unmarshal(enum.Content, &m.content); err != nil {
 		return err
@@ -60,17 +64,20 @@ func (m MoreOptions) MarshalJSON() ([]byte, error) {
     return json.Marshal(enum)
 }
 
-func (m MoreOptions) News() bool {
-	res, _ := m.content.(*bool)
-	return *res
+func (m MoreOptions) News() (bool, bool) {
+	res, ok := m.content.(*bool)
+	if !ok {
+		return false, false
+	}
+	return *res, true
 }
-func (m MoreOptions) Exactly() *MoreOptionsExactlyInner {
-	res, _ := m.content.(*MoreOptionsExactlyInner)
-	return res
+func (m MoreOptions) Exactly() (*MoreOptionsExactlyInner, bool) {
+	res, ok := m.content.(*MoreOptionsExactlyInner)
+	return res, ok
 }
-func (m MoreOptions) Built() *MoreOptionsBuiltInner {
-	res, _ := m.content.(*MoreOptionsBuiltInner)
-	return res
+func (m MoreOptions) Built() (*MoreOptionsBuiltInner, bool) {
+	res, ok := m.content.(*MoreOptionsBuiltInner)
+	return res, ok
 }
 
 func NewMoreOptionsTypeVariantNews(content bool) MoreOptions {
@@ -123,7 +130,8 @@ func (o *Options) UnmarshalJSON(data []byte) error {
 	case OptionsTypeVariantVermont:
 		var res Options
 		o.content = &res
-
+	default:
+		return fmt.Errorf("unknown variant %q for Options", o.Type)
 	}
 	if err := json.Unmarshal(enum.Content, &o.content); err != nil {
 		return err
@@ -142,17 +150,26 @@ func (o Options) MarshalJSON() ([]byte, error) {
     return json.Marshal(enum)
 }
 
-func (o Options) Red() bool {
-	res, _ := o.content.(*bool)
-	return *res
+func (o Options) Red() (bool, bool) {
+	res, ok := o.content.(*bool)
+	if !ok {
+		return false, false
+	}
+	return *res, true
 }
-func (o Options) Banana() string {
-	res, _ := o.content.(*string)
-	return *res
+func (o Options) Banana() (string, bool) {
+	res, ok := o.content.(*string)
+	if !ok {
+		return "", false
+	}
+	return *res, true
 }
-func (o Options) Vermont() Options {
-	res, _ := o.content.(*Options)
-	return *res
+func (o Options) Vermont() (Options, bool) {
+	res, ok := o.content.(*Options)
+	if !ok {
+		return Options{}, false
+	}
+	return *res, true
 }
 
 func NewOptionsTypeVariantRed(content bool) Options {
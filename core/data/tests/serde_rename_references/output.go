@@ -2,6 +2,7 @@ package proto
 
 import (
     "encoding/json"
+    "fmt"
 )
 
 type AliasTest []SomethingFoo
@@ -37,7 +38,8 @@ func (p *Parent) UnmarshalJSON(data []byte) error {
 	case ParentTypeVariantB:
 		var res SomethingFoo
 		p.value = &res
-
+	default:
+		return fmt.Errorf("unknown variant %q for Parent", p.Type)
 	}
 	if err := json.Unmarshal(enum.Content, &p.value); err != nil {
 		return err
@@ -56,9 +58,12 @@ func (p Parent) MarshalJSON() ([]byte, error) {
     return json.Marshal(enum)
 }
 
-func (p Parent) B() SomethingFoo {
-	res, _ := p.value.(*SomethingFoo)
-	return *res
+func (p Parent) B() (SomethingFoo, bool) {
+	res, ok := p.value.(*SomethingFoo)
+	if !ok {
+		return SomethingFoo{}, false
+	}
+	return *res, true
 }
 
 func NewParentTypeVariantB(content SomethingFoo) Parent {
@@ -1,6 +1,9 @@
 package proto
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // This is a comment.
 type ArcyColors struct {
@@ -62,7 +65,8 @@ func (b *BoxyColors) UnmarshalJSON(data []byte) error {
 	case BoxyColorsTypeVariantGreen:
 		var res string
 		b.content = &res
-
+	default:
+		return fmt.Errorf("unknown variant %q for BoxyColors", b.Type)
 	}
 	if err := json.Unmarshal(enum.Content, &b.content); err != nil {
 		return err
@@ -81,9 +85,12 @@ func (b BoxyColors) MarshalJSON() ([]byte, error) {
     return json.Marshal(enum)
 }
 
-func (b BoxyColors) Green() string {
-	res, _ := b.content.(*string)
-	return *res
+func (b BoxyColors) Green() (string, bool) {
+	res, ok := b.content.(*string)
+	if !ok {
+		return "", false
+	}
+	return *res, true
 }
 
 func NewBoxyColorsTypeVariantRed() BoxyColors {
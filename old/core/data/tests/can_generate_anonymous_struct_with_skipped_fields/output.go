@@ -1,6 +1,9 @@
 package proto
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // Generated type representing the anonymous struct variant `Us` of the `AutofilledBy` Rust enum
 type AutofilledByUsInner struct {
@@ -42,7 +45,8 @@ func (a *AutofilledBy) UnmarshalJSON(data []byte) error {
 	case AutofilledByTypeVariantSomethingElse:
 		var res AutofilledBySomethingElseInner
 		a.content = &res
-
+	default:
+		return fmt.Errorf("unknown variant %q for AutofilledBy", a.Type)
 	}
 	if err := json.Unmarshal(enum.Content, &a.content); err != nil {
 		return err
@@ -61,13 +65,13 @@ func (a AutofilledBy) MarshalJSON() ([]byte, error) {
     return json.Marshal(enum)
 }
 
-func (a AutofilledBy) Us() *AutofilledByUsInner {
-	res, _ := a.content.(*AutofilledByUsInner)
-	return res
+func (a AutofilledBy) Us() (*AutofilledByUsInner, bool) {
+	res, ok := a.content.(*AutofilledByUsInner)
+	return res, ok
 }
-func (a AutofilledBy) SomethingElse() *AutofilledBySomethingElseInner {
-	res, _ := a.content.(*AutofilledBySomethingElseInner)
-	return res
+func (a AutofilledBy) SomethingElse() (*AutofilledBySomethingElseInner, bool) {
+	res, ok := a.content.(*AutofilledBySomethingElseInner)
+	return res, ok
 }
 
 func NewAutofilledByTypeVariantUs(content *AutofilledByUsInner) AutofilledBy {